@@ -0,0 +1,18 @@
+// Package tree_sitter_zortex provides Go bindings to the tree-sitter grammar
+// for Zortex note files.
+package tree_sitter_zortex
+
+// #cgo CFLAGS: -std=c11 -fPIC
+// #include "../../src/parser.c"
+// #if __has_include("../../src/scanner.c")
+// #include "../../src/scanner.c"
+// #endif
+import "C"
+
+import "unsafe"
+
+// Language returns the tree-sitter Language for this grammar, for use with
+// the tree-sitter bindings in github.com/tree-sitter/go-tree-sitter.
+func Language() unsafe.Pointer {
+	return unsafe.Pointer(C.tree_sitter_zortex())
+}
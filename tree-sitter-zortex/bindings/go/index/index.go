@@ -0,0 +1,251 @@
+// Package index builds and serves an in-memory graph of the articles, tags
+// and inter-article links found across a directory of .zortex files, using
+// the tree-sitter grammar to parse each file.
+package index
+
+import (
+	"crypto/sha256"
+	"encoding/gob"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	tree_sitter_zortex "github.com/t-wilkinson/zortex.nvim/bindings/go"
+)
+
+// Article is a single parsed .zortex file.
+type Article struct {
+	Name  string
+	Path  string
+	Tags  []string
+	Links []ArticleLink
+}
+
+// ArticleLink is an outgoing link from an Article, with the 1-based line it
+// appears on.
+type ArticleLink struct {
+	Target string
+	Line   int
+}
+
+// Reference is a link from one article to another.
+type Reference struct {
+	From string
+	To   string
+	Line int
+}
+
+// Match is a single hit from Index.Search.
+type Match struct {
+	Article string
+	Line    int
+	Text    string
+}
+
+// entry is the bookkeeping the Index keeps per file, including enough state
+// to decide whether a file needs to be re-parsed on the next Update.
+type entry struct {
+	Article Article
+	ModTime time.Time
+	Hash    [sha256.Size]byte
+	Lines   []string
+}
+
+// Index is an in-memory graph of articles, tags and links, built by parsing
+// a directory of .zortex files with the tree-sitter grammar.
+type Index struct {
+	mu      sync.RWMutex
+	dir     string
+	entries map[string]*entry // keyed by article name
+}
+
+// New returns an empty Index. Call Update to populate it from dir.
+func New(dir string) *Index {
+	return &Index{dir: dir, entries: make(map[string]*entry)}
+}
+
+// Update walks the index's directory and (re)parses any .zortex file that is
+// new, or whose modification time and content hash have both changed since
+// the last Update. A file with an unchanged modification time is not read; a
+// file whose modification time changed but whose content hash did not only
+// has its recorded modification time updated. Entries for files no longer
+// present are removed.
+func (idx *Index) Update() error {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	seen := make(map[string]bool, len(idx.entries))
+
+	err := filepath.Walk(idx.dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || filepath.Ext(path) != ".zortex" {
+			return nil
+		}
+
+		name := articleName(path)
+		seen[name] = true
+
+		if existing, ok := idx.entries[name]; ok && existing.ModTime.Equal(info.ModTime()) {
+			return nil
+		}
+
+		source, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("index: reading %s: %w", path, err)
+		}
+
+		hash := sha256.Sum256(source)
+		if existing, ok := idx.entries[name]; ok && existing.Hash == hash {
+			existing.ModTime = info.ModTime()
+			return nil
+		}
+
+		parsed, err := parseArticle(name, path, source)
+		if err != nil {
+			return fmt.Errorf("index: parsing %s: %w", path, err)
+		}
+
+		idx.entries[name] = &entry{
+			Article: parsed,
+			ModTime: info.ModTime(),
+			Hash:    hash,
+			Lines:   strings.Split(string(source), "\n"),
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for name := range idx.entries {
+		if !seen[name] {
+			delete(idx.entries, name)
+		}
+	}
+	return nil
+}
+
+func articleName(path string) string {
+	return strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+}
+
+func parseArticle(name, path string, source []byte) (Article, error) {
+	tags, err := tree_sitter_zortex.ExtractTags(source)
+	if err != nil {
+		return Article{}, err
+	}
+	links, err := tree_sitter_zortex.ExtractLinks(source)
+	if err != nil {
+		return Article{}, err
+	}
+
+	article := Article{Name: name, Path: path}
+	for _, tag := range tags {
+		article.Tags = append(article.Tags, tag.Name)
+	}
+	for _, link := range links {
+		article.Links = append(article.Links, ArticleLink{
+			Target: link.Target,
+			Line:   int(link.StartPoint.Row) + 1,
+		})
+	}
+	return article, nil
+}
+
+// Backlinks returns every reference to article from elsewhere in the index.
+func (idx *Index) Backlinks(article string) []Reference {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	var refs []Reference
+	for name, e := range idx.entries {
+		if name == article {
+			continue
+		}
+		for _, link := range e.Article.Links {
+			if link.Target == article {
+				refs = append(refs, Reference{From: name, To: article, Line: link.Line})
+			}
+		}
+	}
+	return refs
+}
+
+// Tagged returns every article tagged with tag.
+func (idx *Index) Tagged(tag string) []Article {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	var articles []Article
+	for _, e := range idx.entries {
+		for _, t := range e.Article.Tags {
+			if t == tag {
+				articles = append(articles, e.Article)
+				break
+			}
+		}
+	}
+	return articles
+}
+
+// Search returns every line across the index that contains query, as a
+// case-insensitive substring match.
+func (idx *Index) Search(query string) []Match {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	query = strings.ToLower(query)
+
+	var matches []Match
+	for name, e := range idx.entries {
+		for i, line := range e.Lines {
+			if strings.Contains(strings.ToLower(line), query) {
+				matches = append(matches, Match{Article: name, Line: i + 1, Text: line})
+			}
+		}
+	}
+	return matches
+}
+
+// persisted is the on-disk representation of an Index, written with
+// encoding/gob so that a large vault has a fast cold start.
+type persisted struct {
+	Dir     string
+	Entries map[string]*entry
+}
+
+// Save persists the index to path so that it can be loaded with Load instead
+// of being rebuilt from scratch.
+func (idx *Index) Save(path string) error {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("index: creating %s: %w", path, err)
+	}
+	defer f.Close()
+
+	return gob.NewEncoder(f).Encode(persisted{Dir: idx.dir, Entries: idx.entries})
+}
+
+// Load reads an index previously written with Save.
+func Load(path string) (*Index, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("index: opening %s: %w", path, err)
+	}
+	defer f.Close()
+
+	var p persisted
+	if err := gob.NewDecoder(f).Decode(&p); err != nil {
+		return nil, fmt.Errorf("index: decoding %s: %w", path, err)
+	}
+
+	return &Index{dir: p.Dir, entries: p.Entries}, nil
+}
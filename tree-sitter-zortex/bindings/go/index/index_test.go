@@ -0,0 +1,67 @@
+package index_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/t-wilkinson/zortex.nvim/bindings/go/index"
+)
+
+func writeArticle(t *testing.T, dir, name, body string) {
+	t.Helper()
+	path := filepath.Join(dir, name+".zortex")
+	if err := os.WriteFile(path, []byte(body), 0o644); err != nil {
+		t.Fatalf("writing %s: %v", path, err)
+	}
+}
+
+func TestIndexBuildAndQuery(t *testing.T) {
+	dir := t.TempDir()
+	writeArticle(t, dir, "Alpha", "@@Alpha\nSee [[Beta]] for more, tagged @golang.\n")
+	writeArticle(t, dir, "Beta", "@@Beta\nNo outgoing links here.\n")
+
+	idx := index.New(dir)
+	if err := idx.Update(); err != nil {
+		t.Fatalf("Update returned error: %v", err)
+	}
+
+	backlinks := idx.Backlinks("Beta")
+	if len(backlinks) != 1 || backlinks[0].From != "Alpha" || backlinks[0].Line != 2 {
+		t.Errorf("expected a single backlink from Alpha on line 2, got %+v", backlinks)
+	}
+
+	tagged := idx.Tagged("golang")
+	if len(tagged) != 1 || tagged[0].Name != "Alpha" {
+		t.Errorf("expected Alpha tagged with golang, got %+v", tagged)
+	}
+
+	matches := idx.Search("outgoing")
+	if len(matches) != 1 || matches[0].Article != "Beta" {
+		t.Errorf("expected a single match in Beta, got %+v", matches)
+	}
+}
+
+func TestIndexSaveAndLoad(t *testing.T) {
+	dir := t.TempDir()
+	writeArticle(t, dir, "Alpha", "@@Alpha\nSee [[Beta]].\n")
+
+	idx := index.New(dir)
+	if err := idx.Update(); err != nil {
+		t.Fatalf("Update returned error: %v", err)
+	}
+
+	snapshot := filepath.Join(dir, "index.gob")
+	if err := idx.Save(snapshot); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+
+	loaded, err := index.Load(snapshot)
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+
+	if got, want := len(loaded.Backlinks("Beta")), 1; got != want {
+		t.Errorf("expected %d backlink after reload, got %d", want, got)
+	}
+}
@@ -0,0 +1,207 @@
+package tree_sitter_zortex
+
+import (
+	_ "embed"
+	"fmt"
+	"strings"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+//go:embed queries/links.scm
+var linksQuery string
+
+//go:embed queries/tags.scm
+var tagsQuery string
+
+//go:embed queries/headings.scm
+var headingsQuery string
+
+// Point is a row/column position within a source file, both zero-indexed.
+type Point struct {
+	Row    uint
+	Column uint
+}
+
+func pointFromNode(p tree_sitter.Point) Point {
+	return Point{Row: uint(p.Row), Column: uint(p.Column)}
+}
+
+// Link is a wiki-style [[link]] found in a Zortex source file.
+type Link struct {
+	Target     string
+	Alias      string
+	StartByte  uint
+	EndByte    uint
+	StartPoint Point
+	EndPoint   Point
+}
+
+// Tag is an inline @tag reference found in a Zortex source file.
+type Tag struct {
+	Name       string
+	StartByte  uint
+	EndByte    uint
+	StartPoint Point
+	EndPoint   Point
+}
+
+// Heading is an article title (@@Title) or in-article subheading (#, ##, ...).
+type Heading struct {
+	// Level is 0 for an article title and the number of '#' markers for a
+	// subheading.
+	Level      int
+	Text       string
+	StartByte  uint
+	EndByte    uint
+	StartPoint Point
+	EndPoint   Point
+}
+
+// parse parses source with the Zortex grammar and returns the resulting
+// tree. Callers must call tree.Close() when done.
+func parse(source []byte) (*tree_sitter.Tree, error) {
+	parser := tree_sitter.NewParser()
+	defer parser.Close()
+
+	if err := parser.SetLanguage(tree_sitter.NewLanguage(Language())); err != nil {
+		return nil, fmt.Errorf("zortex: setting language: %w", err)
+	}
+
+	tree := parser.Parse(source, nil)
+	if tree == nil {
+		return nil, fmt.Errorf("zortex: failed to parse source")
+	}
+	return tree, nil
+}
+
+// runQuery executes pattern against source and calls visit once per match,
+// with captures keyed by capture name.
+func runQuery(source []byte, pattern string, visit func(captures map[string]tree_sitter.Node)) error {
+	tree, err := parse(source)
+	if err != nil {
+		return err
+	}
+	defer tree.Close()
+
+	language := tree_sitter.NewLanguage(Language())
+	query, queryErr := tree_sitter.NewQuery(language, pattern)
+	if queryErr != nil {
+		return fmt.Errorf("zortex: compiling query: %w", queryErr)
+	}
+	defer query.Close()
+
+	cursor := tree_sitter.NewQueryCursor()
+	defer cursor.Close()
+
+	matches := cursor.Matches(query, tree.RootNode(), source)
+	for {
+		match := matches.Next()
+		if match == nil {
+			break
+		}
+
+		captures := make(map[string]tree_sitter.Node, len(match.Captures))
+		for _, capture := range match.Captures {
+			name := query.CaptureNames()[capture.Index]
+			captures[name] = capture.Node
+		}
+		visit(captures)
+	}
+	return nil
+}
+
+func nodeText(source []byte, node tree_sitter.Node) string {
+	return node.Utf8Text(source)
+}
+
+// ExtractLinks runs the bundled links query against source and returns every
+// wiki-style [[link]], with the byte offsets and row/column positions of the
+// whole link node (brackets included) within source.
+func ExtractLinks(source []byte) ([]Link, error) {
+	var links []Link
+	err := runQuery(source, linksQuery, func(captures map[string]tree_sitter.Node) {
+		link, ok := captures["link"]
+		if !ok {
+			return
+		}
+
+		var alias string
+		if node, ok := captures["link.alias"]; ok {
+			alias = nodeText(source, node)
+		}
+
+		links = append(links, Link{
+			Target:     nodeText(source, captures["link.target"]),
+			Alias:      alias,
+			StartByte:  uint(link.StartByte()),
+			EndByte:    uint(link.EndByte()),
+			StartPoint: pointFromNode(link.StartPosition()),
+			EndPoint:   pointFromNode(link.EndPosition()),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return links, nil
+}
+
+// ExtractTags runs the bundled tags query against source and returns every
+// inline @tag reference, with byte offsets and row/column positions.
+func ExtractTags(source []byte) ([]Tag, error) {
+	var tags []Tag
+	err := runQuery(source, tagsQuery, func(captures map[string]tree_sitter.Node) {
+		node, ok := captures["tag"]
+		if !ok {
+			return
+		}
+
+		tags = append(tags, Tag{
+			Name:       strings.TrimPrefix(nodeText(source, node), "@"),
+			StartByte:  uint(node.StartByte()),
+			EndByte:    uint(node.EndByte()),
+			StartPoint: pointFromNode(node.StartPosition()),
+			EndPoint:   pointFromNode(node.EndPosition()),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// ExtractHeadings runs the bundled headings query against source and returns
+// every article title and in-article subheading, with byte offsets and
+// row/column positions.
+func ExtractHeadings(source []byte) ([]Heading, error) {
+	var headings []Heading
+	err := runQuery(source, headingsQuery, func(captures map[string]tree_sitter.Node) {
+		heading, ok := captures["heading"]
+		if !ok {
+			return
+		}
+
+		level := 0
+		if marker, ok := captures["heading.level"]; ok {
+			level = len(nodeText(source, marker))
+		}
+
+		text := ""
+		if node, ok := captures["heading.text"]; ok {
+			text = nodeText(source, node)
+		}
+
+		headings = append(headings, Heading{
+			Level:      level,
+			Text:       text,
+			StartByte:  uint(heading.StartByte()),
+			EndByte:    uint(heading.EndByte()),
+			StartPoint: pointFromNode(heading.StartPosition()),
+			EndPoint:   pointFromNode(heading.EndPosition()),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return headings, nil
+}
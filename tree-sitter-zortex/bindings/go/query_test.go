@@ -0,0 +1,58 @@
+package tree_sitter_zortex_test
+
+import (
+	"testing"
+
+	tree_sitter_zortex "github.com/t-wilkinson/zortex.nvim/bindings/go"
+)
+
+func TestExtractLinks(t *testing.T) {
+	source := []byte("See [[Other Article|other]] for details.\n")
+
+	links, err := tree_sitter_zortex.ExtractLinks(source)
+	if err != nil {
+		t.Fatalf("ExtractLinks returned error: %v", err)
+	}
+	if len(links) != 1 {
+		t.Fatalf("expected 1 link, got %d", len(links))
+	}
+	if links[0].Target != "Other Article" {
+		t.Errorf("expected target %q, got %q", "Other Article", links[0].Target)
+	}
+	if links[0].Alias != "other" {
+		t.Errorf("expected alias %q, got %q", "other", links[0].Alias)
+	}
+}
+
+func TestExtractTags(t *testing.T) {
+	source := []byte("A note about @golang and @tree-sitter.\n")
+
+	tags, err := tree_sitter_zortex.ExtractTags(source)
+	if err != nil {
+		t.Fatalf("ExtractTags returned error: %v", err)
+	}
+	if len(tags) != 2 {
+		t.Fatalf("expected 2 tags, got %d", len(tags))
+	}
+	if tags[0].Name != "golang" || tags[1].Name != "tree-sitter" {
+		t.Errorf("unexpected tag names: %+v", tags)
+	}
+}
+
+func TestExtractHeadings(t *testing.T) {
+	source := []byte("@@My Article\n## A Subheading\n")
+
+	headings, err := tree_sitter_zortex.ExtractHeadings(source)
+	if err != nil {
+		t.Fatalf("ExtractHeadings returned error: %v", err)
+	}
+	if len(headings) != 2 {
+		t.Fatalf("expected 2 headings, got %d", len(headings))
+	}
+	if headings[0].Level != 0 || headings[0].Text != "My Article" {
+		t.Errorf("unexpected article heading: %+v", headings[0])
+	}
+	if headings[1].Level != 2 || headings[1].Text != "A Subheading" {
+		t.Errorf("unexpected subheading: %+v", headings[1])
+	}
+}
@@ -0,0 +1,82 @@
+package tree_sitter_zortex_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	tree_sitter_zortex "github.com/t-wilkinson/zortex.nvim/bindings/go"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// containsKind reports whether node or any of its descendants has the given
+// kind, as produced by the external scanner's tokens (article, list,
+// code_block, math).
+func containsKind(node tree_sitter.Node, kind string) bool {
+	if node.Kind() == kind {
+		return true
+	}
+	for i := uint(0); i < node.NamedChildCount(); i++ {
+		if child := node.NamedChild(i); child != nil && containsKind(*child, kind) {
+			return true
+		}
+	}
+	return false
+}
+
+func parseFixture(t *testing.T, name string) *tree_sitter.Node {
+	t.Helper()
+
+	source, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("reading fixture %s: %v", name, err)
+	}
+
+	parser := tree_sitter.NewParser()
+	if err := parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_zortex.Language())); err != nil {
+		t.Fatalf("setting language: %v", err)
+	}
+	t.Cleanup(parser.Close)
+
+	tree := parser.Parse(source, nil)
+	if tree == nil {
+		t.Fatalf("failed to parse fixture %s", name)
+	}
+	t.Cleanup(tree.Close)
+
+	return tree.RootNode()
+}
+
+func TestScannerArticleStart(t *testing.T) {
+	root := parseFixture(t, "article.zortex")
+	if !containsKind(*root, "article") {
+		t.Errorf("expected an article node, got tree: %s", root.ToSexp())
+	}
+}
+
+func TestScannerNestedList(t *testing.T) {
+	root := parseFixture(t, "nested_list.zortex")
+	if !containsKind(*root, "list") {
+		t.Errorf("expected a nested list node, got tree: %s", root.ToSexp())
+	}
+}
+
+func TestScannerCodeFence(t *testing.T) {
+	root := parseFixture(t, "code_fence.zortex")
+	if !containsKind(*root, "code_block") {
+		t.Errorf("expected a code_block node, got tree: %s", root.ToSexp())
+	}
+	if root.HasError() {
+		t.Errorf("fenced code block reported a parse error: %s", root.ToSexp())
+	}
+}
+
+func TestScannerMathDelim(t *testing.T) {
+	root := parseFixture(t, "math.zortex")
+	if !containsKind(*root, "math") {
+		t.Errorf("expected a math node, got tree: %s", root.ToSexp())
+	}
+	if root.HasError() {
+		t.Errorf("inline math reported a parse error: %s", root.ToSexp())
+	}
+}
@@ -0,0 +1,82 @@
+package tree_sitter_zortex
+
+import (
+	"fmt"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// Session owns a persistent parser and the last tree it produced, so that a
+// caller such as a language server can keep parsing a large Zortex vault
+// incrementally rather than re-parsing whole files from scratch on every
+// keystroke.
+type Session struct {
+	parser *tree_sitter.Parser
+	tree   *tree_sitter.Tree
+}
+
+// NewSession creates a Session and performs the initial parse of source.
+func NewSession(source []byte) (*Session, error) {
+	parser := tree_sitter.NewParser()
+	if err := parser.SetLanguage(tree_sitter.NewLanguage(Language())); err != nil {
+		parser.Close()
+		return nil, fmt.Errorf("zortex: setting language: %w", err)
+	}
+
+	tree := parser.Parse(source, nil)
+	if tree == nil {
+		parser.Close()
+		return nil, fmt.Errorf("zortex: failed to parse source")
+	}
+
+	return &Session{parser: parser, tree: tree}, nil
+}
+
+// Tree returns the most recently parsed tree.
+func (s *Session) Tree() *tree_sitter.Tree {
+	return s.tree
+}
+
+// Close releases the parser and tree held by the session.
+func (s *Session) Close() {
+	if s.tree != nil {
+		s.tree.Close()
+		s.tree = nil
+	}
+	if s.parser != nil {
+		s.parser.Close()
+		s.parser = nil
+	}
+}
+
+// ApplyEdit records a single text edit against the session's current tree,
+// mirroring the tree.Edit workflow described in the go-tree-sitter docs.
+// Call Reparse afterwards with the edited source to obtain the updated tree.
+func (s *Session) ApplyEdit(startByte, oldEndByte, newEndByte uint, startPoint, oldEndPoint, newEndPoint Point) {
+	s.tree.Edit(&tree_sitter.InputEdit{
+		StartByte:      startByte,
+		OldEndByte:     oldEndByte,
+		NewEndByte:     newEndByte,
+		StartPosition:  toTSPoint(startPoint),
+		OldEndPosition: toTSPoint(oldEndPoint),
+		NewEndPosition: toTSPoint(newEndPoint),
+	})
+}
+
+// Reparse parses newSource, reusing the edited tree recorded by ApplyEdit to
+// perform an incremental reparse, and stores the result as the session's
+// current tree.
+func (s *Session) Reparse(newSource []byte) (*tree_sitter.Tree, error) {
+	tree := s.parser.Parse(newSource, s.tree)
+	if tree == nil {
+		return nil, fmt.Errorf("zortex: failed to reparse source")
+	}
+
+	s.tree.Close()
+	s.tree = tree
+	return s.tree, nil
+}
+
+func toTSPoint(p Point) tree_sitter.Point {
+	return tree_sitter.Point{Row: p.Row, Column: p.Column}
+}
@@ -0,0 +1,39 @@
+package tree_sitter_zortex_test
+
+import (
+	"testing"
+
+	tree_sitter_zortex "github.com/t-wilkinson/zortex.nvim/bindings/go"
+)
+
+func TestSessionIncrementalReparse(t *testing.T) {
+	source := []byte("@@My Article\nHello world\n")
+
+	session, err := tree_sitter_zortex.NewSession(source)
+	if err != nil {
+		t.Fatalf("NewSession returned error: %v", err)
+	}
+	defer session.Close()
+
+	if session.Tree().RootNode().HasError() {
+		t.Fatalf("initial parse reported an error")
+	}
+
+	edited := []byte("@@My Article\nHello there, world\n")
+
+	// Replace "world" (byte 19) with "there, world".
+	session.ApplyEdit(
+		19, 24, 31,
+		tree_sitter_zortex.Point{Row: 1, Column: 6},
+		tree_sitter_zortex.Point{Row: 1, Column: 11},
+		tree_sitter_zortex.Point{Row: 1, Column: 18},
+	)
+
+	tree, err := session.Reparse(edited)
+	if err != nil {
+		t.Fatalf("Reparse returned error: %v", err)
+	}
+	if tree.RootNode().HasError() {
+		t.Fatalf("reparse reported an error")
+	}
+}
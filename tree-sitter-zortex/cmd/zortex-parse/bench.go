@@ -0,0 +1,60 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+func runBench(args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: zortex-parse bench <dir>")
+	}
+
+	parser, err := newParser()
+	if err != nil {
+		return err
+	}
+	defer parser.Close()
+
+	var fileCount int
+	var byteCount int64
+	start := time.Now()
+
+	err = filepath.Walk(args[0], func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || filepath.Ext(path) != ".zortex" {
+			return nil
+		}
+
+		source, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		tree := parser.Parse(source, nil)
+		if tree == nil {
+			return fmt.Errorf("failed to parse %s", path)
+		}
+		tree.Close()
+
+		fileCount++
+		byteCount += int64(len(source))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	elapsed := time.Since(start)
+	if elapsed == 0 {
+		elapsed = time.Nanosecond
+	}
+
+	mbPerSec := float64(byteCount) / elapsed.Seconds() / (1 << 20)
+	fmt.Printf("parsed %d files (%d bytes) in %s (%.2f MB/s)\n", fileCount, byteCount, elapsed, mbPerSec)
+	return nil
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// astNode is the JSON representation of a single tree-sitter node.
+type astNode struct {
+	Type      string    `json:"type"`
+	StartByte uint      `json:"start_byte"`
+	EndByte   uint      `json:"end_byte"`
+	Text      string    `json:"text,omitempty"`
+	Children  []astNode `json:"children,omitempty"`
+}
+
+func toASTNode(node tree_sitter.Node, source []byte) astNode {
+	n := astNode{
+		Type:      node.Kind(),
+		StartByte: uint(node.StartByte()),
+		EndByte:   uint(node.EndByte()),
+	}
+
+	count := node.NamedChildCount()
+	if count == 0 {
+		n.Text = node.Utf8Text(source)
+		return n
+	}
+
+	n.Children = make([]astNode, 0, count)
+	for i := uint(0); i < count; i++ {
+		if child := node.NamedChild(i); child != nil {
+			n.Children = append(n.Children, toASTNode(*child, source))
+		}
+	}
+	return n
+}
+
+func runJSON(args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: zortex-parse json <file>")
+	}
+
+	source, err := os.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	parser, err := newParser()
+	if err != nil {
+		return err
+	}
+	defer parser.Close()
+
+	tree := parser.Parse(source, nil)
+	if tree == nil {
+		return fmt.Errorf("failed to parse %s", args[0])
+	}
+	defer tree.Close()
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(toASTNode(*tree.RootNode(), source))
+}
@@ -0,0 +1,46 @@
+// Command zortex-parse is a scriptable way to introspect the Zortex grammar
+// without writing Go: it prints parse trees as S-expressions or JSON, runs
+// ad-hoc tree-sitter queries, and benchmarks parse throughput over a vault.
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "parse":
+		err = runParse(os.Args[2:])
+	case "json":
+		err = runJSON(os.Args[2:])
+	case "query":
+		err = runQuery(os.Args[2:])
+	case "bench":
+		err = runBench(os.Args[2:])
+	default:
+		usage()
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "zortex-parse:", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, `usage: zortex-parse <command> [arguments]
+
+commands:
+  parse <file>        print the parse tree as an S-expression
+  json <file>         print the parse tree as JSON
+  query <file> <scm>  run a tree-sitter query file and print its matches
+  bench <dir>         parse every .zortex file under dir and report throughput`)
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func runParse(args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: zortex-parse parse <file>")
+	}
+
+	source, err := os.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	parser, err := newParser()
+	if err != nil {
+		return err
+	}
+	defer parser.Close()
+
+	tree := parser.Parse(source, nil)
+	if tree == nil {
+		return fmt.Errorf("failed to parse %s", args[0])
+	}
+	defer tree.Close()
+
+	fmt.Println(tree.RootNode().ToSexp())
+	return nil
+}
@@ -0,0 +1,19 @@
+package main
+
+import (
+	"fmt"
+
+	tree_sitter_zortex "github.com/t-wilkinson/zortex.nvim/bindings/go"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// newParser returns a Parser configured with the Zortex language. Callers
+// must call parser.Close() when done.
+func newParser() (*tree_sitter.Parser, error) {
+	parser := tree_sitter.NewParser()
+	if err := parser.SetLanguage(tree_sitter.NewLanguage(tree_sitter_zortex.Language())); err != nil {
+		parser.Close()
+		return nil, fmt.Errorf("setting language: %w", err)
+	}
+	return parser, nil
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	tree_sitter_zortex "github.com/t-wilkinson/zortex.nvim/bindings/go"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+func runQuery(args []string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("usage: zortex-parse query <file> <scm>")
+	}
+
+	source, err := os.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+	pattern, err := os.ReadFile(args[1])
+	if err != nil {
+		return err
+	}
+
+	parser, err := newParser()
+	if err != nil {
+		return err
+	}
+	defer parser.Close()
+
+	tree := parser.Parse(source, nil)
+	if tree == nil {
+		return fmt.Errorf("failed to parse %s", args[0])
+	}
+	defer tree.Close()
+
+	language := tree_sitter.NewLanguage(tree_sitter_zortex.Language())
+	query, queryErr := tree_sitter.NewQuery(language, string(pattern))
+	if queryErr != nil {
+		return fmt.Errorf("compiling query %s: %w", args[1], queryErr)
+	}
+	defer query.Close()
+
+	cursor := tree_sitter.NewQueryCursor()
+	defer cursor.Close()
+
+	names := query.CaptureNames()
+	matches := cursor.Matches(query, tree.RootNode(), source)
+	for {
+		match := matches.Next()
+		if match == nil {
+			break
+		}
+		for _, capture := range match.Captures {
+			text := capture.Node.Utf8Text(source)
+			point := capture.Node.StartPosition()
+			fmt.Printf("%s\t%d:%d\t%s\n", names[capture.Index], point.Row+1, point.Column+1, text)
+		}
+	}
+	return nil
+}